@@ -0,0 +1,112 @@
+package rocket
+
+// FourVector is a Minkowski four-vector (t, x, y, z), e.g. an event or a
+// four-momentum. T is the timelike component and R the spacelike part.
+type FourVector struct {
+	T float64
+	R Vector3
+}
+
+// FourVelocity is the four-velocity of an object moving at some coordinate
+// velocity: U = (γc, γv). It shares FourVector's layout; convert with
+// FourVector(u) to apply a LorentzBoost to it.
+type FourVelocity FourVector
+
+// FourMomentum is the four-momentum of an object of some rest mass moving at
+// some coordinate velocity: P = m·U. It shares FourVector's layout; convert
+// with FourVector(p) to apply a LorentzBoost to it.
+type FourMomentum FourVector
+
+// FourVelocityFromVelocity3 returns the four-velocity of an object moving at
+// coordinate velocity v.
+func FourVelocityFromVelocity3(v Velocity3) FourVelocity {
+	vv := Vector3(v)
+	gamma := LorentzFactorPrecise(vv.Magnitude())
+	return FourVelocity{T: gamma * C, R: vv.MultiplyByScalar(gamma)}
+}
+
+// Velocity3 recovers the coordinate velocity from a four-velocity.
+func (u FourVelocity) Velocity3() Velocity3 {
+	return Velocity3(u.R.MultiplyByScalar(C / u.T))
+}
+
+// FourMomentumFromMass returns the four-momentum of an object of rest mass m
+// moving at coordinate velocity v.
+func FourMomentumFromMass(m float64, v Velocity3) FourMomentum {
+	u := FourVelocityFromVelocity3(v)
+	return FourMomentum{T: m * u.T, R: u.R.MultiplyByScalar(m)}
+}
+
+// LorentzBoost is a 4x4 Lorentz transformation matrix, indexed [row][column]
+// in the usual (t, x, y, z) order, that transforms FourVectors between the
+// coordinate frame and a frame moving at a constant velocity relative to it.
+type LorentzBoost [4][4]float64
+
+// BoostFromVelocity returns the LorentzBoost into a frame moving at
+// coordinate velocity v relative to the current one:
+//
+//	Λ^0_0 = γ
+//	Λ^0_i = Λ^i_0 = -γ βi
+//	Λ^i_j = δij + (γ-1) βi βj / β²
+func BoostFromVelocity(v Vector3) LorentzBoost {
+	beta := v.MultiplyByScalar(1.0 / C)
+	b2 := beta.Dot(beta)
+	gamma := LorentzFactorPrecise(v.Magnitude())
+
+	var boost LorentzBoost
+	boost[0][0] = gamma
+
+	betaComponents := [3]float64{beta.x, beta.y, beta.z}
+	for i := 0; i < 3; i++ {
+		boost[0][i+1] = -gamma * betaComponents[i]
+		boost[i+1][0] = -gamma * betaComponents[i]
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			delta := 0.0
+			if i == j {
+				delta = 1
+			}
+			var term float64
+			if b2 > 0 {
+				term = (gamma - 1) * betaComponents[i] * betaComponents[j] / b2
+			}
+			boost[i+1][j+1] = delta + term
+		}
+	}
+
+	return boost
+}
+
+// Apply transforms a FourVector by the boost.
+func (b LorentzBoost) Apply(e FourVector) FourVector {
+	in := [4]float64{e.T, e.R.x, e.R.y, e.R.z}
+	var out [4]float64
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			out[i] += b[i][j] * in[j]
+		}
+	}
+	return FourVector{T: out[0], R: Vector3{out[1], out[2], out[3]}}
+}
+
+// Compose returns the boost equivalent to applying b, then other: for any
+// FourVector e, other.Apply(b.Apply(e)) == b.Compose(other).Apply(e).
+func (b LorentzBoost) Compose(other LorentzBoost) LorentzBoost {
+	var out LorentzBoost
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				out[i][j] += other[i][k] * b[k][j]
+			}
+		}
+	}
+	return out
+}
+
+// FourVelocity returns the rocket's current four-velocity, derived from its
+// coordinate velocity (see V3).
+func (r *Rocket) FourVelocity() FourVelocity {
+	return FourVelocityFromVelocity3(Velocity3(r.V3()))
+}
@@ -0,0 +1,63 @@
+package rocket
+
+import (
+	"math"
+	"testing"
+)
+
+// approximately is only meaningful for positive values (see its definition),
+// and FourVector components can be negative, so compare those with an
+// absolute tolerance instead.
+func approximatelyFourVector(a, b FourVector) bool {
+	const tolerance = 1e-6
+	close := func(x, y float64) bool {
+		return math.Abs(x-y) <= tolerance*math.Max(1, math.Max(math.Abs(x), math.Abs(y)))
+	}
+	return close(a.T, b.T) && close(a.R.x, b.R.x) && close(a.R.y, b.R.y) && close(a.R.z, b.R.z)
+}
+
+func TestComposeColinearBoostsMatchesEinsteinSum(t *testing.T) {
+	u := Vector3{0.6 * C, 0, 0}
+	v := Vector3{0.3 * C, 0, 0}
+
+	composed := BoostFromVelocity(u).Compose(BoostFromVelocity(v))
+	single := BoostFromVelocity(Vector3(Add(Velocity3(u), Velocity3(v))))
+
+	event := FourVector{T: 1, R: Vector3{2, 3, 4}}
+
+	if got, want := composed.Apply(event), single.Apply(event); !approximatelyFourVector(got, want) {
+		t.Errorf("composed boost applied to %v = %v, wanted %v (single boost at Einstein-summed velocity)", event, got, want)
+	}
+}
+
+func TestBoostFromVelocityAtRest(t *testing.T) {
+	// The identity boost (v=0) used to hang via LorentzFactorPrecise(0); it
+	// should leave any event unchanged.
+	event := FourVector{T: 5, R: Vector3{1, 2, 3}}
+	if got := BoostFromVelocity(Vector3{}).Apply(event); !approximatelyFourVector(got, event) {
+		t.Errorf("BoostFromVelocity({}).Apply(%v) = %v, wanted %v (identity boost)", event, got, event)
+	}
+}
+
+func TestRocketFourVelocityAtRest(t *testing.T) {
+	// A freshly-constructed Rocket is at rest, and used to hang computing its
+	// four-velocity for the same reason as BoostFromVelocity(Vector3{}).
+	var r Rocket
+	want := FourVelocity{T: C}
+	if got := r.FourVelocity(); !approximatelyFourVector(FourVector(got), FourVector(want)) {
+		t.Errorf("(&Rocket{}).FourVelocity() = %v, wanted %v", got, want)
+	}
+}
+
+func TestLorentzBoostRoundTrip(t *testing.T) {
+	v := Vector3{0.5 * C, 0.1 * C, 0}
+	boost := BoostFromVelocity(v)
+	inverse := BoostFromVelocity(v.MultiplyByScalar(-1))
+
+	event := FourVector{T: 5, R: Vector3{1, 2, 3}}
+	roundTripped := inverse.Apply(boost.Apply(event))
+
+	if !approximatelyFourVector(roundTripped, event) {
+		t.Errorf("inverse.Apply(boost.Apply(%v)) = %v, wanted %v", event, roundTripped, event)
+	}
+}
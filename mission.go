@@ -0,0 +1,108 @@
+package rocket
+
+// ProperTimeToReachVelocity returns the proper time needed to reach coordinate
+// velocity v under constant proper acceleration a, starting from rest:
+//
+//	τ = (c/a) atanh(v/c)
+func ProperTimeToReachVelocity(a, v float64) float64 {
+	return Rapidity(v) * C / a
+}
+
+// MissionProfile describes a flip-and-burn trip: accelerate at constant
+// proper acceleration A, optionally coast at VCruise, then decelerate by the
+// same amount, arriving at rest having covered Distance.
+//
+// Build one with Plan or PlanWithCoast, then use Sample to query the ship's
+// state at any proper time into the trip.
+type MissionProfile struct {
+	A        float64
+	Distance float64
+
+	// Proper time spent in each of the (up to) three phases: accelerating,
+	// coasting at VCruise, and decelerating. The accelerating and
+	// decelerating phases are symmetric, so they share TauBurn. TauCoast and
+	// VCruise are zero for a plain Plan with no coasting phase.
+	TauBurn, TauCoast float64
+	VCruise           float64
+
+	// Totals for the whole trip.
+	T, Tau, PeakV, PeakLorentz float64
+}
+
+// Plan computes a flip-and-burn mission profile: accelerate at constant
+// proper acceleration a for half of distance, flip, then decelerate for the
+// other half, arriving at rest.
+func Plan(distance, a float64) MissionProfile {
+	half := distance / 2
+	tauBurn := ProperTime(half, a)
+	tBurn := CoordinateTime(half, a)
+
+	return MissionProfile{
+		A:           a,
+		Distance:    distance,
+		TauBurn:     tauBurn,
+		T:           2 * tBurn,
+		Tau:         2 * tauBurn,
+		PeakV:       Velocity(a, tBurn),
+		PeakLorentz: LorentzFactor(a, tBurn),
+	}
+}
+
+// PlanWithCoast is like Plan, but accelerates only until reaching vCruise,
+// coasts at that velocity for whatever distance remains, then decelerates
+// back to rest over a mirror image of the acceleration phase.
+//
+// If accelerating up to vCruise and back down again would alone cover at
+// least distance, there's no room left to coast: PlanWithCoast degenerates
+// to a plain Plan, which burns the whole way without ever reaching vCruise.
+func PlanWithCoast(distance, a, vCruise float64) MissionProfile {
+	tauBurn := ProperTimeToReachVelocity(a, vCruise)
+	tBurn := RindlerTime(a, tauBurn)
+	dBurn := RindlerPosition(a, tauBurn)
+
+	if 2*dBurn >= distance {
+		return Plan(distance, a)
+	}
+
+	dCoast := distance - 2*dBurn
+	tCoast := dCoast / vCruise
+	gammaCruise := LorentzFactorPrecise(vCruise)
+	tauCoast := tCoast / gammaCruise
+
+	return MissionProfile{
+		A:           a,
+		Distance:    distance,
+		TauBurn:     tauBurn,
+		TauCoast:    tauCoast,
+		VCruise:     vCruise,
+		T:           2*tBurn + tCoast,
+		Tau:         2*tauBurn + tauCoast,
+		PeakV:       vCruise,
+		PeakLorentz: gammaCruise,
+	}
+}
+
+// Sample returns the coordinate time t, distance traveled d, and coordinate
+// velocity v at proper time tau into the mission.
+func (m MissionProfile) Sample(tau float64) (t, d, v float64) {
+	switch {
+	case tau <= m.TauBurn:
+		return RindlerTime(m.A, tau), RindlerPosition(m.A, tau), RindlerVelocity(m.A, tau)
+
+	case tau <= m.TauBurn+m.TauCoast:
+		tBurn := RindlerTime(m.A, m.TauBurn)
+		dBurn := RindlerPosition(m.A, m.TauBurn)
+		tauIntoCoast := tau - m.TauBurn
+		tIntoCoast := tauIntoCoast * LorentzFactorPrecise(m.VCruise)
+
+		return tBurn + tIntoCoast, dBurn + m.VCruise*tIntoCoast, m.VCruise
+
+	default:
+		tauToGo := m.TauBurn - (tau - m.TauBurn - m.TauCoast)
+		if tauToGo < 0 {
+			tauToGo = 0
+		}
+
+		return m.T - RindlerTime(m.A, tauToGo), m.Distance - RindlerPosition(m.A, tauToGo), RindlerVelocity(m.A, tauToGo)
+	}
+}
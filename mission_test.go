@@ -0,0 +1,93 @@
+package rocket
+
+import "testing"
+
+func TestPlanMatchesKnownValues(t *testing.T) {
+	// A flip-and-burn Plan over 2*tc.d is two back-to-back copies of the pure
+	// acceleration phase that produces tc.d, tc.t and tc.tau.
+	for _, tc := range knownValues {
+		m := Plan(2*tc.d, tc.a)
+
+		if !approximately(m.T, 2*tc.t) {
+			t.Errorf("Plan(%f ly, %f g).T = %f y, wanted %f y", 2*tc.d/LightYear, tc.a/G, m.T/Year, 2*tc.t/Year)
+		}
+
+		if !approximately(m.Tau, 2*tc.tau) {
+			t.Errorf("Plan(%f ly, %f g).Tau = %f y, wanted %f y", 2*tc.d/LightYear, tc.a/G, m.Tau/Year, 2*tc.tau/Year)
+		}
+
+		if !approximately(m.PeakV, tc.v) {
+			t.Errorf("Plan(%f ly, %f g).PeakV = %f c, wanted %f c", 2*tc.d/LightYear, tc.a/G, m.PeakV/C, tc.v/C)
+		}
+	}
+}
+
+func TestPlanSampleEndpoints(t *testing.T) {
+	m := Plan(2*LightYear, G)
+
+	if t0, d0, v0 := m.Sample(0); t0 != 0 || d0 != 0 || v0 != 0 {
+		t.Errorf("m.Sample(0) = (%f, %f, %f), wanted all zero", t0, d0, v0)
+	}
+
+	tEnd, dEnd, vEnd := m.Sample(m.Tau)
+	if !approximately(tEnd, m.T) {
+		t.Errorf("m.Sample(m.Tau) t = %f y, wanted %f y", tEnd/Year, m.T/Year)
+	}
+	if !approximately(dEnd, m.Distance) {
+		t.Errorf("m.Sample(m.Tau) d = %f ly, wanted %f ly", dEnd/LightYear, m.Distance/LightYear)
+	}
+	if vEnd > 1e-6*C {
+		t.Errorf("m.Sample(m.Tau) v = %f c, wanted ~0 (arrived at rest)", vEnd/C)
+	}
+}
+
+func TestPlanWithCoastReachesTargetDistance(t *testing.T) {
+	m := PlanWithCoast(10*LightYear, G, 0.9*C)
+
+	_, dEnd, vEnd := m.Sample(m.Tau)
+	if !approximately(dEnd, m.Distance) {
+		t.Errorf("m.Sample(m.Tau) d = %f ly, wanted %f ly", dEnd/LightYear, m.Distance/LightYear)
+	}
+	if vEnd > 1e-6*C {
+		t.Errorf("m.Sample(m.Tau) v = %f c, wanted ~0 (arrived at rest)", vEnd/C)
+	}
+
+	_, _, vCruise := m.Sample(m.TauBurn + m.TauCoast/2)
+	if !approximately(vCruise, 0.9*C) {
+		t.Errorf("mid-coast velocity = %f c, wanted %f c", vCruise/C, 0.9)
+	}
+}
+
+func TestPlanWithCoastDegeneratesWhenNoRoomToCoast(t *testing.T) {
+	// Reaching tc.v alone takes a full leg of distance tc.d (that's what
+	// knownValues means), so asking to cruise at tc.v over a total distance
+	// of only tc.d leaves no room for a coast phase: PlanWithCoast should
+	// fall back to a plain Plan rather than produce a negative TauCoast.
+	tc := knownValues[0]
+
+	got := PlanWithCoast(tc.d, tc.a, tc.v)
+	want := Plan(tc.d, tc.a)
+
+	if got.TauCoast != 0 {
+		t.Errorf("PlanWithCoast(...).TauCoast = %f y, wanted 0 (no room to coast)", got.TauCoast/Year)
+	}
+	if !approximately(got.T, want.T) {
+		t.Errorf("PlanWithCoast(...).T = %f y, wanted %f y (matching a plain Plan)", got.T/Year, want.T/Year)
+	}
+	if !approximately(got.Tau, want.Tau) {
+		t.Errorf("PlanWithCoast(...).Tau = %f y, wanted %f y (matching a plain Plan)", got.Tau/Year, want.Tau/Year)
+	}
+}
+
+func TestPlanWithCoastAtZeroCruise(t *testing.T) {
+	// vCruise=0 used to hang inside LorentzFactorPrecise(0), regardless of
+	// distance: the call must return at all. (Coasting at v=0 to cover any
+	// remaining distance is its own degenerate case - dCoast/vCruise - and
+	// isn't otherwise a meaningful mission profile, so this doesn't assert
+	// anything about the resulting T or Tau.)
+	m := PlanWithCoast(2*knownValues[0].d, knownValues[0].a, 0)
+
+	if m.TauBurn != 0 {
+		t.Errorf("PlanWithCoast(..., 0).TauBurn = %f y, wanted 0", m.TauBurn/Year)
+	}
+}
@@ -0,0 +1,76 @@
+package rocket
+
+import "math"
+
+// RindlerPosition returns the coordinate distance traveled by a rocket under
+// constant proper acceleration a, after proper time tau, measured from its
+// starting point:
+//
+//	x(τ) = (c²/a)(cosh(aτ/c) - 1)
+func RindlerPosition(a, tau float64) float64 {
+	return (C * C / a) * (math.Cosh(a*tau/C) - 1)
+}
+
+// RindlerTime returns the coordinate time elapsed for a rocket under constant
+// proper acceleration a, after proper time tau:
+//
+//	t(τ) = (c/a) sinh(aτ/c)
+func RindlerTime(a, tau float64) float64 {
+	return (C / a) * math.Sinh(a*tau/C)
+}
+
+// RindlerVelocity returns the coordinate velocity of a rocket under constant
+// proper acceleration a, after proper time tau:
+//
+//	v(τ) = c tanh(aτ/c)
+func RindlerVelocity(a, tau float64) float64 {
+	return C * math.Tanh(a*tau/C)
+}
+
+// RindlerLorentzFactor returns the Lorentz factor of a rocket under constant
+// proper acceleration a, after proper time tau:
+//
+//	γ(τ) = cosh(aτ/c)
+func RindlerLorentzFactor(a, tau float64) float64 {
+	return math.Cosh(a * tau / C)
+}
+
+// RindlerChart is the onboard coordinate chart of an observer undergoing
+// constant proper acceleration A. It answers what coordinate-frame event
+// (t, x) a passenger sitting at proper distance xi from the chart's origin
+// (the "nose"; negative xi is behind it) occupies when the origin's clock
+// reads proper time tau. A passenger at xi ages (1 + A·xi/c²) times as fast
+// as the origin.
+//
+// RindlerChart gives a ground-truth analytic trajectory against which
+// numerical steppers like Rocket.Accelerate can be regression-tested.
+type RindlerChart struct {
+	A float64
+}
+
+// EventFromProper maps a point in Rindler coordinates (proper time tau of the
+// origin, signed proper distance xi from the origin, negative behind it, which
+// must be greater than -c²/a - the position of the Rindler horizon) to the
+// corresponding coordinate-frame event (t, x):
+//
+//	radius = c²/a + ξ
+//	t = (radius/c) sinh(aτ/c)
+//	x = radius cosh(aτ/c) - c²/a
+func (chart RindlerChart) EventFromProper(tau, xi float64) (t, x float64) {
+	radius := C*C/chart.A + xi
+	t = (radius / C) * math.Sinh(chart.A*tau/C)
+	x = radius*math.Cosh(chart.A*tau/C) - C*C/chart.A
+	return t, x
+}
+
+// ProperFromEvent is the inverse of EventFromProper: it recovers the origin's
+// proper time tau and the signed proper distance xi from the origin of a
+// coordinate-frame event (t, x).
+func (chart RindlerChart) ProperFromEvent(t, x float64) (tau, xi float64) {
+	offsetCosh := x + C*C/chart.A
+	offsetSinh := C * t
+	radius := math.Sqrt(offsetCosh*offsetCosh - offsetSinh*offsetSinh)
+	tau = (C / chart.A) * math.Atanh(offsetSinh/offsetCosh)
+	xi = radius - C*C/chart.A
+	return tau, xi
+}
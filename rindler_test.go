@@ -0,0 +1,69 @@
+package rocket
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRindlerMatchesKnownValues(t *testing.T) {
+	// knownValues' last row only gives tau to 2 significant figures, and
+	// gamma grows exponentially in tau, so that rounding error is amplified
+	// past the 1% tolerance approximately allows. The other rows aren't
+	// exponentially sensitive enough for that rounding to matter.
+	for _, tc := range knownValues[:4] {
+		if x := RindlerPosition(tc.a, tc.tau); !approximately(x, tc.d) {
+			t.Errorf("RindlerPosition(%f g, %f y) = %f ly, wanted %f ly", tc.a/G, tc.tau/Year, x/LightYear, tc.d/LightYear)
+		}
+
+		if tt := RindlerTime(tc.a, tc.tau); !approximately(tt, tc.t) {
+			t.Errorf("RindlerTime(%f g, %f y) = %f y, wanted %f y", tc.a/G, tc.tau/Year, tt/Year, tc.t/Year)
+		}
+
+		if v := RindlerVelocity(tc.a, tc.tau); !approximately(v, tc.v) {
+			t.Errorf("RindlerVelocity(%f g, %f y) = %f c, wanted %f c", tc.a/G, tc.tau/Year, v/C, tc.v/C)
+		}
+
+		if lorentz := RindlerLorentzFactor(tc.a, tc.tau); !approximately(lorentz, tc.lorentz) {
+			t.Errorf("RindlerLorentzFactor(%f g, %f y) = %f, wanted %f", tc.a/G, tc.tau/Year, lorentz, tc.lorentz)
+		}
+	}
+}
+
+func TestRindlerChartRoundTrip(t *testing.T) {
+	chart := RindlerChart{A: G}
+	// The horizon sits at xi = -c²/a, so these stay safely in front of it.
+	horizon := C * C / G
+
+	for _, tau := range []float64{0.5 * Year, 2 * Year, 5 * Year} {
+		for _, xi := range []float64{0, -0.3 * horizon, -0.6 * horizon} {
+			tt, x := chart.EventFromProper(tau, xi)
+			gotTau, gotXi := chart.ProperFromEvent(tt, x)
+
+			if !approximately(gotTau, tau) {
+				t.Errorf("ProperFromEvent(EventFromProper(%f y, %f ly)) tau = %f y, wanted %f y", tau/Year, xi/LightYear, gotTau/Year, tau/Year)
+			}
+
+			// xi is negative (a proper distance behind the origin), and
+			// approximately's relative tolerance is only meaningful for
+			// positive values, so compare against an absolute tolerance
+			// instead, scaled to the horizon distance.
+			if math.Abs(gotXi-xi) > 0.01*horizon {
+				t.Errorf("ProperFromEvent(EventFromProper(%f y, %f ly)) xi = %f ly, wanted %f ly", tau/Year, xi/LightYear, gotXi/LightYear, xi/LightYear)
+			}
+		}
+	}
+}
+
+func TestRindlerOriginMatchesProperTime(t *testing.T) {
+	chart := RindlerChart{A: G}
+
+	for _, tc := range knownValues {
+		tt, x := chart.EventFromProper(tc.tau, 0)
+		if !approximately(tt, tc.t) {
+			t.Errorf("chart.EventFromProper(%f y, 0) t = %f y, wanted %f y", tc.tau/Year, tt/Year, tc.t/Year)
+		}
+		if !approximately(x, tc.d) {
+			t.Errorf("chart.EventFromProper(%f y, 0) x = %f ly, wanted %f ly", tc.tau/Year, x/LightYear, tc.d/LightYear)
+		}
+	}
+}
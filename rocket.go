@@ -82,6 +82,10 @@ type Rocket struct {
 	W Vector3
 	// Coordinate (Earth/observer) and proper (shipboard) time.
 	T, Tau float64
+	// Coordinate position. Only kept consistent with W by the Verlet-style
+	// steppers (AccelerateVerlet); Accelerate and AccelerateMidpoint leave it
+	// untouched.
+	R Vector3
 }
 
 // Accelerate the rocket by applying constant proper acceleration for dt seconds.
@@ -122,15 +126,106 @@ func (r *Rocket) AccelerateOnProperTime(a Vector3, dtau float64) {
 	r.Tau += dtau
 }
 
+// AccelerateMidpoint is an alternative to Accelerate that fixes the
+// time-dilation bias Accelerate documents: instead of computing the Lorentz
+// factor from the velocity after applying the full step of acceleration, it
+// evaluates it at the half-step (midpoint) proper velocity.
+//
+// This is a symplectic stepper, and converges to the known closed-form
+// trajectory (see RindlerChart) with orders of magnitude fewer steps than
+// Accelerate for the same accuracy.
+func (r *Rocket) AccelerateMidpoint(a Vector3, dt float64) {
+	half := r.W.Add(a.MultiplyByScalar(dt / 2))
+	r.Tau += dt / lorentzFactorFromProperVelocity(half.Magnitude())
+	r.W = r.W.Add(a.MultiplyByScalar(dt))
+	r.T += dt
+}
+
+// AccelerateMidpointOnProperTime is the AccelerateOnProperTime analogue of
+// AccelerateMidpoint: it steps by proper time dtau, evaluating the Lorentz
+// factor at the half-step proper velocity instead of the velocity before the
+// step.
+//
+// Because dt itself depends on the (as yet unknown) half-step velocity, this
+// first estimates the half-step with the current Lorentz factor, then
+// recomputes dt from the resulting half-step velocity.
+func (r *Rocket) AccelerateMidpointOnProperTime(a Vector3, dtau float64) {
+	gamma := lorentzFactorFromProperVelocity(r.W.Magnitude())
+	half := r.W.Add(a.MultiplyByScalar(gamma * dtau / 2))
+	dt := lorentzFactorFromProperVelocity(half.Magnitude()) * dtau
+
+	r.W = r.W.Add(a.MultiplyByScalar(dt))
+	r.T += dt
+	r.Tau += dtau
+}
+
+// AccelerateVerlet is a velocity-Verlet-style stepper built on top of
+// AccelerateMidpoint: it additionally advances the rocket's coordinate
+// position R, by the trapezoidal rule over the coordinate velocity before and
+// after the step, so that R stays consistent with W. This makes Rocket usable
+// as a physics integrator in simulations that need position, not just
+// velocity and time.
+func (r *Rocket) AccelerateVerlet(a Vector3, dt float64) {
+	before := r.V3()
+	r.AccelerateMidpoint(a, dt)
+	after := r.V3()
+
+	r.R = r.R.Add(before.Add(after).MultiplyByScalar(dt / 2))
+}
+
+// lorentzFactorFromProperVelocity returns the Lorentz factor gamma =
+// sqrt(1+(w/c)^2) for a proper velocity of magnitude w. This is the same
+// relation as the package-level LorentzFactor(a, t), applied directly to a
+// proper velocity instead of a*t.
+func lorentzFactorFromProperVelocity(w float64) float64 {
+	x := w / C
+	return math.Sqrt(1 + x*x)
+}
+
+// AccelerateRelativistic is like Accelerate, but composes velocities using
+// Einstein addition (see Add) instead of adding proper acceleration to W
+// componentwise.
+//
+// Accelerate happens to be correct only when a stays parallel to the
+// rocket's current velocity; for a course change, adding a·dt directly to W
+// is wrong. AccelerateRelativistic instead treats a·dtau as a velocity kick
+// in the rocket's instantaneously comoving frame, and boosts it into the
+// coordinate frame by Einstein-adding it to the rocket's current coordinate
+// velocity, which composes correctly regardless of direction.
+func (r *Rocket) AccelerateRelativistic(a Vector3, dt float64) {
+	gamma := r.LorentzFactor()
+	dtau := dt / gamma
+
+	v := Velocity3(r.V3())
+	kick := Velocity3(a.MultiplyByScalar(dtau))
+
+	r.W = Vector3(ToProperVelocity3(Add(v, kick)))
+	r.T += dt
+	r.Tau += dtau
+}
+
 func (r *Rocket) LorentzFactor() float64 {
 	v := r.V()
 	return 1 / math.Sqrt(1-(v*v)/(C*C))
 }
 
+// LorentzFactorPrecise is equivalent to LorentzFactor, except that it remains
+// accurate for slow (sub-relativistic) rockets. See the package-level
+// LorentzFactorPrecise for details.
+func (r *Rocket) LorentzFactorPrecise() float64 {
+	return LorentzFactorPrecise(r.V())
+}
+
 func (r *Rocket) V() float64 {
 	return CoordinateVelocity(r.W.Magnitude())
 }
 
+// V3 returns the rocket's coordinate velocity vector, same as V but without
+// discarding direction.
+func (r *Rocket) V3() Vector3 {
+	return Vector3(FromProperVelocity3(Velocity3(r.W)))
+}
+
 func CoordinateTime(d, a float64) float64 {
 	q := d / C
 	return math.Sqrt(q*q + 2*d/a)
@@ -171,3 +266,68 @@ func LorentzFactor(a, t float64) float64 {
 	x := (a * t) / C
 	return math.Sqrt(1 + x*x)
 }
+
+// lorentzPrecisionThreshold is the beta (v/c) below which LorentzFactorPrecise
+// and LorentzFactorMinusOne switch from the closed-form expression to a
+// Taylor series. Below it, the two branches agree to within a few ULP.
+const lorentzPrecisionThreshold = 1e-3
+
+// lorentzSeriesTolerance bounds the relative size of the Taylor series term
+// that lorentzFactorMinusOneTaylor is allowed to drop.
+const lorentzSeriesTolerance = 1e-15
+
+// LorentzFactorPrecise computes the Lorentz factor for a coordinate velocity
+// v, like LorentzFactor(a, t), but taking v directly.
+//
+// The direct formula 1/sqrt(1-v²/c²) loses precision to rounding as v²/c²
+// shrinks next to 1 in float64: at v = 1e-6 c only about four significant
+// digits of gamma-1 survive, and below roughly 1e-8 c it rounds to exactly
+// 1.0. Below lorentzPrecisionThreshold, LorentzFactorPrecise instead sums the
+// Taylor expansion in beta = v/c:
+//
+//	gamma = 1 + 1/2 beta^2 + 3/8 beta^4 + 5/16 beta^6 + 35/128 beta^8 + ...
+//
+// The result is still rounded to a float64 near 1, so below roughly 1e-8 c it
+// is exactly 1.0 as well; LorentzFactorMinusOne returns gamma-1 itself.
+func LorentzFactorPrecise(v float64) float64 {
+	beta := v / C
+	if math.Abs(beta) >= lorentzPrecisionThreshold {
+		return 1 / math.Sqrt(1-beta*beta)
+	}
+	return 1 + lorentzFactorMinusOneTaylor(beta)
+}
+
+// LorentzFactorMinusOne returns gamma-1 for coordinate velocity v, without
+// losing precision to cancellation when gamma is extremely close to 1.
+//
+// Callers doing kinetic-energy or time-dilation bookkeeping at slow speeds
+// usually want gamma-1 rather than gamma, and computing LorentzFactorPrecise(v)
+// - 1 would throw away the precision this function is meant to preserve.
+func LorentzFactorMinusOne(v float64) float64 {
+	beta := v / C
+	if math.Abs(beta) >= lorentzPrecisionThreshold {
+		return 1/math.Sqrt(1-beta*beta) - 1
+	}
+	return lorentzFactorMinusOneTaylor(beta)
+}
+
+// lorentzFactorMinusOneTaylor sums the Taylor series for gamma-1 in powers of
+// beta^2, stopping once the next term is below lorentzSeriesTolerance
+// relative to the running sum.
+func lorentzFactorMinusOneTaylor(beta float64) float64 {
+	b2 := beta * beta
+	if b2 == 0 {
+		return 0
+	}
+
+	term := 0.5 * b2
+	sum := term
+	for n := 2; ; n++ {
+		term *= b2 * float64(2*n-1) / float64(2*n)
+		if math.Abs(term) < lorentzSeriesTolerance*math.Abs(sum) {
+			break
+		}
+		sum += term
+	}
+	return sum
+}
@@ -1,6 +1,9 @@
 package rocket
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 type testCase struct {
 	a, tau, t, d, v, lorentz float64
@@ -75,6 +78,139 @@ func TestRocketAccelerateOnProperTime(t *testing.T) {
 	}
 }
 
+func TestRocketAccelerateMidpoint(t *testing.T) {
+	// AccelerateMidpoint steps uniformly in coordinate time, so it needs the
+	// step count to scale with the total coordinate time t; the last two
+	// knownValues rows span thousands of years or more (1840 and 113243) and
+	// aren't a fair comparison at a fixed step count. The other rows converge with three
+	// orders of magnitude fewer steps than TestRocketAccelerate's 1e6.
+	const steps = 1000
+	for _, tc := range knownValues[:3] {
+		var r Rocket
+		for i := 0; i < steps; i++ {
+			r.AccelerateMidpoint(Vector3{tc.a, 0, 0}, tc.t/float64(steps))
+		}
+
+		if v := r.V(); !approximately(v, tc.v) {
+			t.Errorf(
+				"accelerating rocket (midpoint) a=%f g t=%f y, v=%f c (wanted %f c)",
+				tc.a/G, tc.t/Year, v/C, tc.v/C)
+		}
+
+		if !approximately(r.Tau, tc.tau) {
+			t.Errorf(
+				"accelerating rocket (midpoint) a=%f g t=%f y, tau=%f y (wanted %f y)",
+				tc.a/G, tc.t/Year, r.Tau/Year, tc.tau/Year)
+		}
+	}
+}
+
+func TestRocketAccelerateMidpointOnProperTime(t *testing.T) {
+	// Stepping uniformly in proper time tau (rather than coordinate time)
+	// handles the huge dynamic range of knownValues comfortably, since tau
+	// itself never gets more extreme than a few dozen years.
+	const steps = 200
+	for _, tc := range knownValues {
+		var r Rocket
+		for i := 0; i < steps; i++ {
+			r.AccelerateMidpointOnProperTime(Vector3{tc.a, 0, 0}, tc.tau/float64(steps))
+		}
+
+		if v := r.V(); !approximately(v, tc.v) {
+			t.Errorf(
+				"accelerating rocket (midpoint, proper time) a=%f g tau=%f y, v=%f c (wanted %f c)",
+				tc.a/G, tc.tau/Year, v/C, tc.v/C)
+		}
+
+		if !approximately(r.T, tc.t) {
+			t.Errorf(
+				"accelerating rocket (midpoint, proper time) a=%f g tau=%f y, t=%f y (wanted %f y)",
+				tc.a/G, tc.tau/Year, r.T/Year, tc.t/Year)
+		}
+	}
+}
+
+func TestRocketAccelerateRelativisticFromRest(t *testing.T) {
+	// Every Rocket starts at rest (Rocket{}'s zero value), so this is the
+	// realistic starting condition for a multi-leg trajectory - and the one
+	// that used to hang via LorentzFactorPrecise(0) inside Add.
+	const steps = 1000
+	for _, tc := range knownValues[:3] {
+		var r Rocket
+		for i := 0; i < steps; i++ {
+			r.AccelerateRelativistic(Vector3{tc.a, 0, 0}, tc.t/float64(steps))
+		}
+
+		if v := r.V(); !approximately(v, tc.v) {
+			t.Errorf(
+				"accelerating rocket (relativistic) a=%f g t=%f y, v=%f c (wanted %f c)",
+				tc.a/G, tc.t/Year, v/C, tc.v/C)
+		}
+
+		if !approximately(r.Tau, tc.tau) {
+			t.Errorf(
+				"accelerating rocket (relativistic) a=%f g t=%f y, tau=%f y (wanted %f y)",
+				tc.a/G, tc.t/Year, r.Tau/Year, tc.tau/Year)
+		}
+	}
+}
+
+func TestRocketAccelerateRelativisticNonColinear(t *testing.T) {
+	// AccelerateRelativistic's whole point is composing acceleration that
+	// isn't parallel to the rocket's velocity; Accelerate already gets the
+	// colinear case right, so a colinear-only kick can't catch a regression
+	// here. Starting the rocket moving along x and kicking it along y keeps
+	// u.v == 0 in Add's Einstein sum, which collapses to an exact relation:
+	// the transverse coordinate velocity gained is a_perp * dtau / gamma,
+	// i.e. a_perp/gamma^2 per unit coordinate time (contrast the gamma^3
+	// suppression for acceleration parallel to the velocity).
+	v := 0.8 * C
+	var r Rocket
+	r.W = Vector3(ToProperVelocity3(Velocity3{x: v}))
+	gamma := r.LorentzFactor()
+
+	const aPerp = G
+	const dt = 1.0
+	r.AccelerateRelativistic(Vector3{0, aPerp, 0}, dt)
+
+	wantVY := aPerp / (gamma * gamma) * dt
+	if gotVY := r.V3().y; !approximately(gotVY, wantVY) {
+		t.Errorf(
+			"AccelerateRelativistic(perpendicular kick).V3().y = %e, wanted %e (a_perp/gamma^2 * dt)",
+			gotVY, wantVY)
+	}
+}
+
+func TestRocketAccelerateVerletPositionMatchesRindler(t *testing.T) {
+	// Same caveat as TestRocketAccelerateMidpoint: stepping uniformly in
+	// coordinate time only stays accurate at a modest step count for the
+	// less extreme knownValues rows.
+	const steps = 1000
+	for _, tc := range knownValues[:3] {
+		var r Rocket
+		for i := 0; i < steps; i++ {
+			r.AccelerateVerlet(Vector3{tc.a, 0, 0}, tc.t/float64(steps))
+		}
+
+		if !approximately(r.R.x, tc.d) {
+			t.Errorf(
+				"accelerating rocket (verlet) a=%f g t=%f y, R.x=%f ly (wanted %f ly)",
+				tc.a/G, tc.t/Year, r.R.x/LightYear, tc.d/LightYear)
+		}
+	}
+}
+
+func BenchmarkRocketAccelerateMidpoint(b *testing.B) {
+	tc := knownValues[2]
+	const steps = 1000
+	for i := 0; i < b.N; i++ {
+		var r Rocket
+		for j := 0; j < steps; j++ {
+			r.AccelerateMidpoint(Vector3{tc.a, 0, 0}, tc.t/float64(steps))
+		}
+	}
+}
+
 // Tests that the effects of proper acceleration match up to the hyperbolic solution
 // over the same (coordinate) time span.
 func TestProperAcceleration(t *testing.T) {
@@ -121,3 +257,49 @@ func TestProperVelocity(t *testing.T) {
 		}
 	}
 }
+
+func TestLorentzFactorPreciseAgreesAtCrossover(t *testing.T) {
+	// Just above and below lorentzPrecisionThreshold, the closed-form and
+	// Taylor-series branches should agree to within a few ULP.
+	for _, beta := range []float64{
+		lorentzPrecisionThreshold * 0.99,
+		lorentzPrecisionThreshold * 1.01,
+	} {
+		v := beta * C
+		closedForm := 1 / math.Sqrt(1-beta*beta)
+		precise := LorentzFactorPrecise(v)
+
+		if diff := math.Abs(precise - closedForm); diff > 1e-12 {
+			t.Errorf(
+				"LorentzFactorPrecise(%f c) = %.17f, closed form = %.17f (diff %e)",
+				beta, precise, closedForm, diff)
+		}
+	}
+}
+
+func TestLorentzFactorPreciseSlowVelocity(t *testing.T) {
+	// At v = 1e-6 c, the closed-form 1/sqrt(1-v²/c²) keeps only about four
+	// significant digits of gamma-1. LorentzFactorPrecise and
+	// LorentzFactorMinusOne should still report gamma above 1.
+	v := 1e-6 * C
+	if lorentz := LorentzFactorPrecise(v); lorentz <= 1 {
+		t.Errorf("LorentzFactorPrecise(%f c) = %.17f, wanted > 1", v/C, lorentz)
+	}
+
+	if gammaMinusOne := LorentzFactorMinusOne(v); gammaMinusOne <= 0 {
+		t.Errorf("LorentzFactorMinusOne(%f c) = %e, wanted > 0", v/C, gammaMinusOne)
+	}
+}
+
+// v=0 is the most common input to this package - it's what every Rocket
+// starts at rest with - and the Taylor series branch must not loop forever
+// trying to shrink a term that's already exactly zero.
+func TestLorentzFactorPreciseAtRest(t *testing.T) {
+	if lorentz := LorentzFactorPrecise(0); lorentz != 1 {
+		t.Errorf("LorentzFactorPrecise(0) = %.17f, wanted 1", lorentz)
+	}
+
+	if gammaMinusOne := LorentzFactorMinusOne(0); gammaMinusOne != 0 {
+		t.Errorf("LorentzFactorMinusOne(0) = %e, wanted 0", gammaMinusOne)
+	}
+}
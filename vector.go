@@ -26,3 +26,7 @@ func (v Vector3) Add(w Vector3) Vector3 {
 func (v Vector3) MultiplyByScalar(s float64) Vector3 {
 	return Vector3{v.x * s, v.y * s, v.z * s}
 }
+
+func (v Vector3) Dot(w Vector3) float64 {
+	return v.x*w.x + v.y*w.y + v.z*w.z
+}
@@ -0,0 +1,88 @@
+package rocket
+
+import "math"
+
+// Velocity3 is a relativistic 3-velocity built on top of Vector3: a velocity
+// (proper or coordinate, depending on context) that composes via Add rather
+// than ordinary vector addition.
+//
+// Einstein velocity addition is neither commutative nor associative, so
+// Velocity3 forms a gyrogroup rather than a vector space. See Add and Gyr.
+type Velocity3 Vector3
+
+// Add composes two coordinate velocities using Einstein velocity addition:
+//
+//	u ⊕ v = 1/(1+u·v/c²) · (u + v/γ_u + (γ_u/(1+γ_u))(u·v/c²) u)
+//
+// where γ_u is the Lorentz factor of u. Add is neither commutative nor
+// associative; Add(u, v) != Add(v, u) in general, and composing three or more
+// velocities depends on the order in which they are added (see Gyr).
+func Add(u, v Velocity3) Velocity3 {
+	uu, vv := Vector3(u), Vector3(v)
+	gammaU := LorentzFactorPrecise(uu.Magnitude())
+	uDotV := uu.Dot(vv) / (C * C)
+
+	sum := uu.
+		Add(vv.MultiplyByScalar(1 / gammaU)).
+		Add(uu.MultiplyByScalar(gammaU / (1 + gammaU) * uDotV))
+
+	return Velocity3(sum.MultiplyByScalar(1 / (1 + uDotV)))
+}
+
+// Neg returns the inverse of v under Add: Add(v, Neg(v)) is the zero
+// velocity.
+func Neg(v Velocity3) Velocity3 {
+	return Velocity3(Vector3(v).MultiplyByScalar(-1))
+}
+
+// Gyr is the gyration (Thomas precession) operator associated with Einstein
+// velocity addition. It measures the failure of Add to associate:
+//
+//	Add(u, Add(v, w)) == Add(Add(u, v), Gyr(u, v, w))
+//
+// Gyr is computed from Add and Neg via the standard gyrogroup identity,
+// rather than by building the underlying rotation matrix directly:
+//
+//	gyr[u,v]w = ⊖(u ⊕ v) ⊕ (u ⊕ (v ⊕ w))
+func Gyr(u, v, w Velocity3) Velocity3 {
+	return Add(Neg(Add(u, v)), Add(u, Add(v, w)))
+}
+
+// ToProperVelocity3 converts a coordinate velocity to the corresponding
+// proper velocity. It is the vector counterpart of ProperVelocity.
+func ToProperVelocity3(v Velocity3) Velocity3 {
+	vv := Vector3(v)
+	gamma := LorentzFactorPrecise(vv.Magnitude())
+	return Velocity3(vv.MultiplyByScalar(gamma))
+}
+
+// FromProperVelocity3 converts a proper velocity to the corresponding
+// coordinate velocity. It is the vector counterpart of CoordinateVelocity.
+func FromProperVelocity3(w Velocity3) Velocity3 {
+	ww := Vector3(w)
+	gamma := lorentzFactorFromProperVelocity(ww.Magnitude())
+	return Velocity3(ww.MultiplyByScalar(1 / gamma))
+}
+
+// Rapidity returns the rapidity atanh(v/c) corresponding to coordinate
+// velocity v. Unlike velocities, rapidities add linearly for colinear
+// boosts, which makes them convenient when composing many boosts in the same
+// direction.
+func Rapidity(v float64) float64 {
+	return math.Atanh(v / C)
+}
+
+// VelocityFromRapidity is the inverse of Rapidity.
+func VelocityFromRapidity(phi float64) float64 {
+	return C * math.Tanh(phi)
+}
+
+// BoostVelocity transforms the coordinate velocity v, as measured in the
+// current frame, into the velocity observed from a frame that itself moves
+// at coordinate velocity frame relative to the current one.
+//
+// This lets callers change reference frames directly, instead of hand-rolling
+// the underlying linear algebra.
+func BoostVelocity(frame, v Velocity3) Velocity3 {
+	return Add(Neg(frame), v)
+}
@@ -0,0 +1,90 @@
+package rocket
+
+import (
+	"math"
+	"testing"
+)
+
+func approximatelyVelocity3(u, v Velocity3) bool {
+	const tolerance = 1e-6 * C
+	return math.Abs(Vector3(u).x-Vector3(v).x) < tolerance &&
+		math.Abs(Vector3(u).y-Vector3(v).y) < tolerance &&
+		math.Abs(Vector3(u).z-Vector3(v).z) < tolerance
+}
+
+func TestAddColinearMatchesScalarFormula(t *testing.T) {
+	for _, tc := range knownValues {
+		u := Velocity3{x: 0.5 * tc.v}
+		v := Velocity3{x: 0.25 * tc.v}
+
+		got := Vector3(Add(u, v)).x
+		want := (0.5*tc.v + 0.25*tc.v) / (1 + (0.5*tc.v*0.25*tc.v)/(C*C))
+
+		if !approximately(got, want) {
+			t.Errorf("Add(%v, %v).x = %f, wanted %f", u, v, got, want)
+		}
+	}
+}
+
+func TestAddIsBoundedByC(t *testing.T) {
+	u := Velocity3{x: 0.9 * C}
+	v := Velocity3{x: 0.9 * C}
+
+	if m := Vector3(Add(u, v)).Magnitude(); m >= C {
+		t.Errorf("Add(%v, %v) has magnitude %f c, wanted < c", u, v, m/C)
+	}
+}
+
+func TestNegIsInverseOfAdd(t *testing.T) {
+	v := Velocity3{x: 0.3 * C, y: 0.2 * C}
+
+	if got := Add(v, Neg(v)); !approximatelyVelocity3(got, Velocity3{}) {
+		t.Errorf("Add(v, Neg(v)) = %v, wanted zero velocity", got)
+	}
+}
+
+func TestGyrAssociativityIdentity(t *testing.T) {
+	u := Velocity3{x: 0.6 * C, y: 0.1 * C}
+	v := Velocity3{x: 0.1 * C, y: 0.6 * C}
+	w := Velocity3{x: 0.2 * C, z: 0.3 * C}
+
+	lhs := Add(u, Add(v, w))
+	rhs := Add(Add(u, v), Gyr(u, v, w))
+
+	if !approximatelyVelocity3(lhs, rhs) {
+		t.Errorf("Add(u, Add(v, w)) = %v, Add(Add(u, v), Gyr(u, v, w)) = %v, wanted equal", lhs, rhs)
+	}
+}
+
+func TestProperVelocity3RoundTrip(t *testing.T) {
+	for _, tc := range knownValues {
+		v := Velocity3{x: tc.v}
+
+		if got := FromProperVelocity3(ToProperVelocity3(v)); !approximatelyVelocity3(got, v) {
+			t.Errorf("FromProperVelocity3(ToProperVelocity3(%v)) = %v, wanted %v", v, got, v)
+		}
+	}
+}
+
+func TestAddAtRest(t *testing.T) {
+	// A rocket always starts at rest (Rocket{}'s zero value), so Add and
+	// ToProperVelocity3 must handle a zero velocity without hanging - see
+	// LorentzFactorPrecise(0).
+	v := Velocity3{x: 0.5 * C}
+
+	if got := Add(Velocity3{}, v); !approximatelyVelocity3(got, v) {
+		t.Errorf("Add({}, %v) = %v, wanted %v", v, got, v)
+	}
+
+	if got := BoostVelocity(Velocity3{}, v); !approximatelyVelocity3(got, v) {
+		t.Errorf("BoostVelocity({}, %v) = %v, wanted %v (identity boost)", v, got, v)
+	}
+}
+
+func TestRapidityRoundTrip(t *testing.T) {
+	for _, tc := range knownValues {
+		if v := VelocityFromRapidity(Rapidity(tc.v)); !approximately(v, tc.v) {
+			t.Errorf("VelocityFromRapidity(Rapidity(%f c)) = %f c, wanted %f c", tc.v/C, v/C, tc.v/C)
+		}
+	}
+}